@@ -0,0 +1,105 @@
+// Command greet is the CLI front-end for the greeter library: it can print
+// a greeting directly, list the registered styles, or serve the configured
+// greeter over gRPC using the same construction path as cmd/greeterd.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"greeterdemo/greeter"
+	"greeterdemo/greeterconfig"
+	"greeterdemo/greeterserver"
+)
+
+var (
+	flagStyle  string
+	flagLocale string
+)
+
+func main() {
+	root := &cobra.Command{Use: "greet"}
+	root.PersistentFlags().StringVar(&flagStyle, "style", "", `greeter style (default: $GREET_STYLE, ~/.greetrc, or "formal")`)
+	root.PersistentFlags().StringVar(&flagLocale, "locale", "", "locale for locale-aware styles (default: $GREET_LOCALE or ~/.greetrc)")
+
+	root.AddCommand(sayCmd(), listStylesCmd(), serveCmd())
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func sayCmd() *cobra.Command {
+	var name string
+	var age int
+	cmd := &cobra.Command{
+		Use:   "say",
+		Short: "Print a greeting for one person",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			g, err := greeterFromFlags()
+			if err != nil {
+				return err
+			}
+			p, err := greeter.NewPersonOrDefault(name, age)
+			if err != nil {
+				return err
+			}
+			greeting, err := g.Greet(p)
+			if err != nil {
+				return err
+			}
+			fmt.Println(greeting)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "name of the person to greet")
+	cmd.Flags().IntVar(&age, "age", 0, "age of the person to greet")
+	return cmd
+}
+
+func listStylesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-styles",
+		Short: "List registered greeter styles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, name := range greeter.GreeterRegistry.Styles() {
+				fmt.Println(name)
+			}
+			return nil
+		},
+	}
+}
+
+func serveCmd() *cobra.Command {
+	var addr string
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the configured greeter over gRPC",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := greeterconfig.Load(flagStyle, flagLocale)
+			if err != nil {
+				return err
+			}
+			g, err := cfg.Greeter()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("greet serve: serving %q on %s\n", cfg.Style, addr)
+			return greeterserver.Serve(addr, g)
+		},
+	}
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "address to listen on")
+	return cmd
+}
+
+func greeterFromFlags() (greeter.Greeter, error) {
+	cfg, err := greeterconfig.Load(flagStyle, flagLocale)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Greeter()
+}
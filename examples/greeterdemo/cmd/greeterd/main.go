@@ -0,0 +1,34 @@
+// Command greeterd binds a Greeter, resolved the same way as the greet
+// CLI (flags, then GREET_STYLE/GREET_LOCALE, then ~/.greetrc), to a TCP
+// port and serves it over gRPC.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"greeterdemo/greeterconfig"
+	"greeterdemo/greeterserver"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	style := flag.String("style", "", `greeter style (default: $GREET_STYLE, ~/.greetrc, or "formal")`)
+	locale := flag.String("locale", "", "default locale for locale-aware styles")
+	flag.Parse()
+
+	cfg, err := greeterconfig.Load(*style, *locale)
+	if err != nil {
+		log.Fatalf("greeterd: %v", err)
+	}
+	g, err := cfg.Greeter()
+	if err != nil {
+		log.Fatalf("greeterd: %v", err)
+	}
+
+	fmt.Printf("greeterd: serving %q on %s\n", cfg.Style, *addr)
+	if err := greeterserver.Serve(*addr, g); err != nil {
+		log.Fatalf("greeterd: serve: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package greeter
+
+import "fmt"
+
+// FormalGreeter implements Greeter with formal style
+type FormalGreeter struct {
+	Prefix string
+}
+
+func (g FormalGreeter) Greet(p Person) (string, error) {
+	if err := validatePerson(p); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s, %s! You are %d years old.",
+		g.Prefix, p.Name, p.Age), nil
+}
+
+func NewFormalGreeter() *FormalGreeter {
+	return &FormalGreeter{Prefix: "Good day"}
+}
+
+func init() {
+	GreeterRegistry.Register("formal", func(opts Options) Greeter {
+		g := NewFormalGreeter()
+		if opts.Prefix != "" {
+			g.Prefix = opts.Prefix
+		}
+		return g
+	})
+}
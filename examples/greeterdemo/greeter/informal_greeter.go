@@ -0,0 +1,29 @@
+package greeter
+
+import "fmt"
+
+// InformalGreeter implements Greeter with a casual style
+type InformalGreeter struct {
+	Prefix string
+}
+
+func (g InformalGreeter) Greet(p Person) (string, error) {
+	if err := validatePerson(p); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s! %d, huh? Nice.", g.Prefix, p.Name, p.Age), nil
+}
+
+func NewInformalGreeter() *InformalGreeter {
+	return &InformalGreeter{Prefix: "Hey"}
+}
+
+func init() {
+	GreeterRegistry.Register("informal", func(opts Options) Greeter {
+		g := NewInformalGreeter()
+		if opts.Prefix != "" {
+			g.Prefix = opts.Prefix
+		}
+		return g
+	})
+}
@@ -0,0 +1,56 @@
+package greeter
+
+import "fmt"
+
+// localizedPhrases maps a BCP-47 language tag to its greeting word.
+var localizedPhrases = map[string]string{
+	"en": "Hello",
+	"fr": "Bonjour",
+	"ja": "こんにちは",
+}
+
+// LocalizedGreeter implements Greeter by picking a phrase for p.Locale (or
+// its Options.Locale default), falling back to "en" and then to a plain
+// generic phrase if neither is known.
+type LocalizedGreeter struct {
+	Locale  string
+	Phrases map[string]string
+}
+
+func (g LocalizedGreeter) Greet(p Person) (string, error) {
+	if err := validatePerson(p); err != nil {
+		return "", err
+	}
+	phrase := g.phraseFor(p.Locale)
+	return fmt.Sprintf("%s, %s!", phrase, p.Name), nil
+}
+
+// phraseFor resolves the greeting word for locale, falling back to the
+// greeter's configured default locale, then to English, then to a generic
+// phrase understood regardless of locale.
+func (g LocalizedGreeter) phraseFor(locale string) string {
+	if phrase, ok := g.Phrases[locale]; ok {
+		return phrase
+	}
+	if phrase, ok := g.Phrases[g.Locale]; ok {
+		return phrase
+	}
+	if phrase, ok := g.Phrases["en"]; ok {
+		return phrase
+	}
+	return "Hello"
+}
+
+func NewLocalizedGreeter(locale string) *LocalizedGreeter {
+	return &LocalizedGreeter{Locale: locale, Phrases: localizedPhrases}
+}
+
+func init() {
+	GreeterRegistry.Register("localized", func(opts Options) Greeter {
+		locale := opts.Locale
+		if locale == "" {
+			locale = "en"
+		}
+		return NewLocalizedGreeter(locale)
+	})
+}
@@ -0,0 +1,44 @@
+package greeter
+
+import "testing"
+
+func TestLocalizedGreeterPhraseFor(t *testing.T) {
+	g := LocalizedGreeter{
+		Locale:  "fr",
+		Phrases: map[string]string{"en": "Hello", "fr": "Bonjour"},
+	}
+
+	cases := []struct {
+		name   string
+		locale string
+		want   string
+	}{
+		{"unknown locale falls back to greeter's default locale", "ja", "Bonjour"},
+		{"known locale is used as-is", "en", "Hello"},
+		{"empty locale falls back to greeter's default locale", "", "Bonjour"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := g.phraseFor(c.locale); got != c.want {
+				t.Fatalf("phraseFor(%q) = %q, want %q", c.locale, got, c.want)
+			}
+		})
+	}
+}
+
+func TestLocalizedGreeterPhraseForFallsBackToEnglish(t *testing.T) {
+	g := LocalizedGreeter{
+		Locale:  "de", // not in Phrases either
+		Phrases: map[string]string{"en": "Hello", "fr": "Bonjour"},
+	}
+	if got, want := g.phraseFor("ja"), "Hello"; got != want {
+		t.Fatalf("phraseFor(%q) = %q, want %q", "ja", got, want)
+	}
+}
+
+func TestLocalizedGreeterPhraseForUltimateFallback(t *testing.T) {
+	g := LocalizedGreeter{Locale: "de", Phrases: map[string]string{}}
+	if got, want := g.phraseFor("ja"), "Hello"; got != want {
+		t.Fatalf("phraseFor(%q) = %q, want %q", "ja", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package greeter
+
+import (
+	"fmt"
+	"sort"
+)
+
+// greeterRegistry is a map of style name to factory function, populated by
+// each Greeter implementation's init().
+type greeterRegistry struct {
+	factories map[string]func(Options) Greeter
+}
+
+// Register adds a factory under name, overwriting any previous registration.
+// It is meant to be called from an init() func, including from external
+// packages that import this one.
+func (r *greeterRegistry) Register(name string, factory func(Options) Greeter) {
+	if r.factories == nil {
+		r.factories = make(map[string]func(Options) Greeter)
+	}
+	r.factories[name] = factory
+}
+
+// New builds the Greeter registered under name, or an error if no such
+// style has been registered.
+func (r *greeterRegistry) New(name string, opts Options) (Greeter, error) {
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("greeter: no style registered under %q", name)
+	}
+	return factory(opts), nil
+}
+
+// Styles returns the names of all registered greeter styles, sorted
+// alphabetically.
+func (r *greeterRegistry) Styles() []string {
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GreeterRegistry is the process-wide registry of greeter styles.
+var GreeterRegistry = &greeterRegistry{}
@@ -0,0 +1,20 @@
+package greeter
+
+import "testing"
+
+func TestGreeterRegistryNew(t *testing.T) {
+	g, err := GreeterRegistry.New("formal", Options{})
+	if err != nil {
+		t.Fatalf("New(%q) returned error: %v", "formal", err)
+	}
+	if g == nil {
+		t.Fatalf("New(%q) returned a nil Greeter", "formal")
+	}
+}
+
+func TestGreeterRegistryNewUnknownStyle(t *testing.T) {
+	_, err := GreeterRegistry.New("nonexistent", Options{})
+	if err == nil {
+		t.Fatal("New with an unregistered style should return an error")
+	}
+}
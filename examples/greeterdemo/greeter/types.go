@@ -0,0 +1,65 @@
+package greeter
+
+import "fmt"
+
+const (
+	DefaultName = "World"
+	MaxAge      = 150
+)
+
+// Person represents someone who can be greeted
+type Person struct {
+	Name string
+	Age  int
+	// Locale is a BCP-47 language tag (e.g. "en", "fr", "ja") used by
+	// locale-aware greeters. Empty means "no preference".
+	Locale string
+}
+
+// String returns a short human-readable form, e.g. "Alice (42)".
+func (p Person) String() string {
+	return fmt.Sprintf("%s (%d)", p.Name, p.Age)
+}
+
+// NewPerson validates name and age and returns a Person, or an error if
+// name is empty or age falls outside [0, MaxAge].
+func NewPerson(name string, age int) (Person, error) {
+	p := Person{Name: name, Age: age}
+	if err := validatePerson(p); err != nil {
+		return Person{}, err
+	}
+	return p, nil
+}
+
+// NewPersonOrDefault behaves like NewPerson, except an empty name is
+// replaced with DefaultName instead of being rejected.
+func NewPersonOrDefault(name string, age int) (Person, error) {
+	if name == "" {
+		name = DefaultName
+	}
+	return NewPerson(name, age)
+}
+
+// validatePerson reports whether p has a usable name and an age within
+// [0, MaxAge]. It is shared by the Person constructors and by Greeter
+// implementations that need to reject nonsense input before greeting it.
+func validatePerson(p Person) error {
+	if p.Name == "" {
+		return fmt.Errorf("greeter: person name must not be empty")
+	}
+	if p.Age < 0 || p.Age > MaxAge {
+		return fmt.Errorf("greeter: age %d out of range [0, %d]", p.Age, MaxAge)
+	}
+	return nil
+}
+
+// Options configures a Greeter at construction time.
+type Options struct {
+	Prefix string
+	Locale string
+}
+
+// Greeter defines greeting behavior
+type Greeter interface {
+	Greet(p Person) (string, error)
+}
@@ -0,0 +1,51 @@
+package greeter
+
+import "testing"
+
+func TestValidatePerson(t *testing.T) {
+	cases := []struct {
+		name    string
+		person  Person
+		wantErr bool
+	}{
+		{"age zero", Person{Name: "Alice", Age: 0}, false},
+		{"age max", Person{Name: "Alice", Age: MaxAge}, false},
+		{"age over max", Person{Name: "Alice", Age: MaxAge + 1}, true},
+		{"age negative", Person{Name: "Alice", Age: -1}, true},
+		{"empty name", Person{Name: "", Age: 42}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validatePerson(c.person)
+			if c.wantErr && err == nil {
+				t.Fatalf("validatePerson(%+v) = nil, want error", c.person)
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("validatePerson(%+v) = %v, want nil", c.person, err)
+			}
+		})
+	}
+}
+
+func TestNewPersonRejectsEmptyName(t *testing.T) {
+	if _, err := NewPerson("", 42); err == nil {
+		t.Fatal("NewPerson with an empty name should return an error")
+	}
+}
+
+func TestNewPersonOrDefaultFillsInName(t *testing.T) {
+	p, err := NewPersonOrDefault("", 42)
+	if err != nil {
+		t.Fatalf("NewPersonOrDefault returned error: %v", err)
+	}
+	if p.Name != DefaultName {
+		t.Fatalf("NewPersonOrDefault name = %q, want %q", p.Name, DefaultName)
+	}
+}
+
+func TestPersonString(t *testing.T) {
+	p := Person{Name: "Alice", Age: 42}
+	if got, want := p.String(), "Alice (42)"; got != want {
+		t.Fatalf("String() = %q, want %q", got, want)
+	}
+}
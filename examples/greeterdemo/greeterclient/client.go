@@ -0,0 +1,50 @@
+// Package greeterclient is a thin wrapper around greeterpb.GreeterClient so
+// callers can greet a remote greeterd the same way they'd call a local
+// greeter.Greeter.
+package greeterclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"greeterdemo/greeter"
+	"greeterdemo/greeterpb"
+)
+
+// Client greets people via a remote greeterd instance.
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  greeterpb.GreeterClient
+}
+
+// Dial returns a Client for the greeterd listening at addr. No connection
+// is made here: it is opened on the first call, so an unreachable addr is
+// reported by Greet rather than by Dial.
+func Dial(addr string) (*Client, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, rpc: greeterpb.NewGreeterClient(conn)}, nil
+}
+
+// Close releases the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Greet returns the same string the server's Greeter.Greet would produce
+// locally for p.
+func (c *Client) Greet(ctx context.Context, p greeter.Person) (string, error) {
+	resp, err := c.rpc.Hello(ctx, &greeterpb.HelloRequest{
+		Name:   p.Name,
+		Age:    int32(p.Age),
+		Locale: p.Locale,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetGreeting(), nil
+}
@@ -0,0 +1,57 @@
+// Package greeterconfig resolves the style and locale a Greeter should be
+// built with, so the CLI, the server, and any other caller all construct
+// greeters the same way instead of each wiring flags/env/config by hand.
+package greeterconfig
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+
+	"greeterdemo/greeter"
+)
+
+const defaultStyle = "formal"
+
+// Config holds the resolved greeter construction parameters.
+type Config struct {
+	Style  string `toml:"style"`
+	Locale string `toml:"locale"`
+}
+
+// Load resolves Style and Locale from, in priority order: flagStyle and
+// flagLocale (pass "" for "not set" — typically CLI flags), the
+// GREET_STYLE and GREET_LOCALE environment variables, the ~/.greetrc TOML
+// file, and finally defaultStyle.
+func Load(flagStyle, flagLocale string) (Config, error) {
+	cfg := Config{Style: defaultStyle}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		path := filepath.Join(home, ".greetrc")
+		if _, err := toml.DecodeFile(path, &cfg); err != nil && !os.IsNotExist(err) {
+			return Config{}, err
+		}
+	}
+
+	if v := os.Getenv("GREET_STYLE"); v != "" {
+		cfg.Style = v
+	}
+	if v := os.Getenv("GREET_LOCALE"); v != "" {
+		cfg.Locale = v
+	}
+
+	if flagStyle != "" {
+		cfg.Style = flagStyle
+	}
+	if flagLocale != "" {
+		cfg.Locale = flagLocale
+	}
+
+	return cfg, nil
+}
+
+// Greeter builds the Greeter described by cfg via the shared registry.
+func (cfg Config) Greeter() (greeter.Greeter, error) {
+	return greeter.GreeterRegistry.New(cfg.Style, greeter.Options{Locale: cfg.Locale})
+}
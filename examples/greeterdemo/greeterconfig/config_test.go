@@ -0,0 +1,82 @@
+package greeterconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withHome points $HOME at a fresh temp dir and, if rc is non-empty, writes
+// it to ~/.greetrc there.
+func withHome(t *testing.T, rc string) {
+	t.Helper()
+	home := t.TempDir()
+	if rc != "" {
+		if err := os.WriteFile(filepath.Join(home, ".greetrc"), []byte(rc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("HOME", home)
+}
+
+func TestLoadDefaultsWithNothingSet(t *testing.T) {
+	withHome(t, "")
+	t.Setenv("GREET_STYLE", "")
+	t.Setenv("GREET_LOCALE", "")
+
+	cfg, err := Load("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Style != defaultStyle || cfg.Locale != "" {
+		t.Fatalf("Load(\"\", \"\") = %+v, want {%q, \"\"}", cfg, defaultStyle)
+	}
+}
+
+func TestLoadReadsGreetrc(t *testing.T) {
+	withHome(t, `style = "informal"
+locale = "fr"
+`)
+	t.Setenv("GREET_STYLE", "")
+	t.Setenv("GREET_LOCALE", "")
+
+	cfg, err := Load("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Style != "informal" || cfg.Locale != "fr" {
+		t.Fatalf("Load(\"\", \"\") = %+v, want {informal, fr}", cfg)
+	}
+}
+
+func TestLoadEnvOverridesGreetrc(t *testing.T) {
+	withHome(t, `style = "informal"
+locale = "fr"
+`)
+	t.Setenv("GREET_STYLE", "localized")
+	t.Setenv("GREET_LOCALE", "ja")
+
+	cfg, err := Load("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Style != "localized" || cfg.Locale != "ja" {
+		t.Fatalf("Load(\"\", \"\") = %+v, want {localized, ja}", cfg)
+	}
+}
+
+func TestLoadFlagOverridesEnvAndGreetrc(t *testing.T) {
+	withHome(t, `style = "informal"
+locale = "fr"
+`)
+	t.Setenv("GREET_STYLE", "localized")
+	t.Setenv("GREET_LOCALE", "ja")
+
+	cfg, err := Load("formal", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Style != "formal" || cfg.Locale != "en" {
+		t.Fatalf("Load(\"formal\", \"en\") = %+v, want {formal, en}", cfg)
+	}
+}
@@ -0,0 +1,54 @@
+// Package greeterserver adapts an in-process greeter.Greeter into the
+// generated greeterpb.GreeterServer gRPC interface.
+package greeterserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"greeterdemo/greeter"
+	"greeterdemo/greeterpb"
+)
+
+// Server adapts a greeter.Greeter to the greeterpb.GreeterServer interface.
+type Server struct {
+	greeterpb.UnimplementedGreeterServer
+	Greeter greeter.Greeter
+}
+
+// New wraps g so it can be registered with a grpc.Server via
+// greeterpb.RegisterGreeterServer.
+func New(g greeter.Greeter) *Server {
+	return &Server{Greeter: g}
+}
+
+func (s *Server) Hello(ctx context.Context, req *greeterpb.HelloRequest) (*greeterpb.HelloResponse, error) {
+	person := greeter.Person{
+		Name:   req.GetName(),
+		Age:    int(req.GetAge()),
+		Locale: req.GetLocale(),
+	}
+	greeting, err := s.Greeter.Greet(person)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return &greeterpb.HelloResponse{Greeting: greeting}, nil
+}
+
+// Serve builds a grpc.Server wrapping g, registers it, and blocks serving
+// requests on addr. Both cmd/greeterd and greet serve call this instead of
+// each wiring up their own listener and grpc.Server.
+func Serve(addr string, g greeter.Greeter) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	s := grpc.NewServer()
+	greeterpb.RegisterGreeterServer(s, New(g))
+	return s.Serve(lis)
+}